@@ -95,6 +95,18 @@ func (o Op) String() string {
 // Has reports if this operation has the given operation.
 func (o Op) Has(h Op) bool { return o&h == h }
 
+// Ops returns the individual operations in this Op, in the same order as
+// [Op.String]. It returns nil if no known operations are set.
+func (o Op) Ops() []Op {
+	var ops []Op
+	for _, op := range []Op{Create, Remove, Write, Rename, Chmod} {
+		if o.Has(op) {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
 // Has reports if this event has the given operation.
 func (e Event) Has(op Op) bool { return e.Op.Has(op) }
 
@@ -1299,6 +1299,28 @@ func TestEventString(t *testing.T) {
 	}
 }
 
+func TestOpOps(t *testing.T) {
+	tests := []struct {
+		in   Op
+		want []Op
+	}{
+		{0, nil},
+		{Create, []Op{Create}},
+		{Chmod | Create, []Op{Create, Chmod}},
+		{Write | Remove | Rename, []Op{Remove, Write, Rename}},
+		{Create | Write | Remove | Rename | Chmod, []Op{Create, Remove, Write, Rename, Chmod}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			have := tt.in.Ops()
+			if !reflect.DeepEqual(have, tt.want) {
+				t.Errorf("\nhave: %s\nwant: %s", have, tt.want)
+			}
+		})
+	}
+}
+
 // Verify the watcher can keep up with file creations/deletions when under load.
 func TestWatchStress(t *testing.T) {
 	if isCI() {